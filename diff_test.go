@@ -0,0 +1,61 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseHunkRange(t *testing.T) {
+	tests := []struct {
+		spec       string
+		start, cnt int
+	}{
+		{"7", 7, 1},
+		{"7,1", 7, 1},
+		{"7,3", 7, 3},
+		{"13,0", 13, 0},
+	}
+	for _, tt := range tests {
+		start, count := parseHunkRange(tt.spec)
+		if start != tt.start || count != tt.cnt {
+			t.Errorf("parseHunkRange(%q) = (%d, %d), want (%d, %d)", tt.spec, start, count, tt.start, tt.cnt)
+		}
+	}
+}
+
+func TestParseHunkLines(t *testing.T) {
+	// Two hunks: a single modified line and a 3-line added block.
+	diff := []byte(`diff --git a/demo.go b/demo.go
+--- a/demo.go
++++ b/demo.go
+@@ -7 +7 @@ func Add(a, b int) int {
+-	return a+b
++	return a + b
+@@ -12,0 +13,3 @@ func Unused() int {
++	x := 1
++	y := 2
++	_ = x + y
+`)
+
+	got := parseHunkLines(diff)
+	want := map[int]bool{7: true, 13: true, 14: true, 15: true}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseHunkLines() = %v, want %v", got, want)
+	}
+}
+
+func TestParseHunkLinesPureDeletion(t *testing.T) {
+	// A hunk that only removes lines adds nothing on the "+" side.
+	diff := []byte(`diff --git a/demo.go b/demo.go
+--- a/demo.go
++++ b/demo.go
+@@ -7,2 +6,0 @@ func Add(a, b int) int {
+-	// stale comment
+-
+`)
+
+	got := parseHunkLines(diff)
+	if len(got) != 0 {
+		t.Errorf("parseHunkLines() = %v, want empty set for a pure deletion", got)
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/packages"
+)
+
+func TestPkgFromLoadedResolvesDir(t *testing.T) {
+	p := &packages.Package{
+		PkgPath: "example.com/m",
+		GoFiles: []string{"/src/example.com/m/foo.go", "/src/example.com/m/bar.go"},
+	}
+
+	pkg := pkgFromLoaded(p)
+	if pkg.Error != nil {
+		t.Fatalf("Error = %v, want nil", pkg.Error)
+	}
+	if pkg.Dir != "/src/example.com/m" {
+		t.Errorf("Dir = %q, want %q", pkg.Dir, "/src/example.com/m")
+	}
+}
+
+func TestPkgFromLoadedSurfacesLoadErrors(t *testing.T) {
+	p := &packages.Package{
+		PkgPath: "example.com/broken",
+		Errors:  []packages.Error{{Msg: "no required module provides package"}},
+	}
+
+	pkg := pkgFromLoaded(p)
+	if pkg.Dir != "" {
+		t.Errorf("Dir = %q, want empty when the package failed to load", pkg.Dir)
+	}
+	if pkg.Error == nil {
+		t.Fatal("Error = nil, want the load error surfaced")
+	}
+}
+
+// TestPkgFromLoadedNoGoFilesIsUnresolved covers the case this function was
+// added to fix: a package with no GoFiles but no reported Errors either
+// (e.g. every source file is excluded by build constraints for the current
+// GOOS/GOARCH). It must not fall back to the module's root directory,
+// which would point findFile at the wrong place for any non-root package.
+func TestPkgFromLoadedNoGoFilesIsUnresolved(t *testing.T) {
+	p := &packages.Package{
+		PkgPath: "example.com/m/sub",
+		Module:  &packages.Module{Path: "example.com/m", Dir: "/src/example.com/m"},
+	}
+
+	pkg := pkgFromLoaded(p)
+	if pkg.Dir != "" {
+		t.Errorf("Dir = %q, want empty rather than the module root", pkg.Dir)
+	}
+	if pkg.Error == nil {
+		t.Fatal("Error = nil, want this package treated as unresolved")
+	}
+}
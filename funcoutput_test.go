@@ -0,0 +1,90 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/tools/cover"
+)
+
+func TestFuncName(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"package p\nfunc F() {}", "F"},
+		{"package p\nfunc (t T) M() {}", "T.M"},
+		{"package p\nfunc (t *T) M() {}", "(*T).M"},
+	}
+	for _, tt := range tests {
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, "p.go", tt.src, 0)
+		if err != nil {
+			t.Fatalf("ParseFile(%q): %v", tt.src, err)
+		}
+		fd := f.Decls[0].(*ast.FuncDecl)
+		if got := funcName(fd); got != tt.want {
+			t.Errorf("funcName(%q) = %q, want %q", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestFuncExtentCoverage(t *testing.T) {
+	// Lines 2-5, spanning two profile blocks (an if-branch and its
+	// fallthrough), mimicking a function compiled to multiple blocks.
+	f := &funcExtent{startLine: 2, startCol: 1, endLine: 5, endCol: 2}
+	blocks := []cover.ProfileBlock{
+		{StartLine: 2, StartCol: 1, EndLine: 3, EndCol: 2, NumStmt: 2, Count: 1},
+		{StartLine: 4, StartCol: 1, EndLine: 4, EndCol: 10, NumStmt: 1, Count: 0},
+		// Outside f's extent entirely; must not be counted.
+		{StartLine: 10, StartCol: 1, EndLine: 10, EndCol: 5, NumStmt: 1, Count: 1},
+	}
+
+	covered, total := f.coverage(blocks)
+	if covered != 2 || total != 3 {
+		t.Errorf("coverage() = (%d, %d), want (2, 3)", covered, total)
+	}
+}
+
+func TestFindFuncsSkipsBodylessDecls(t *testing.T) {
+	src := `package p
+
+func Real() {
+	_ = 1
+}
+
+func Asm()
+`
+	file := filepath.Join(t.TempDir(), "p.go")
+	if err := os.WriteFile(file, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	funcs, err := findFuncs(file)
+	if err != nil {
+		t.Fatalf("findFuncs: %v", err)
+	}
+	if len(funcs) != 1 || funcs[0].name != "Real" {
+		t.Fatalf("findFuncs() = %v, want only the bodied Real func", funcs)
+	}
+}
+
+func TestPercentage(t *testing.T) {
+	tests := []struct {
+		covered, total int64
+		want           string
+	}{
+		{0, 0, "0.0%"},
+		{1, 2, "50.0%"},
+		{3, 3, "100.0%"},
+	}
+	for _, tt := range tests {
+		if got := percentage(tt.covered, tt.total); got != tt.want {
+			t.Errorf("percentage(%d, %d) = %q, want %q", tt.covered, tt.total, got, tt.want)
+		}
+	}
+}
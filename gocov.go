@@ -1,20 +1,26 @@
 package main
 
 import (
-	"bytes"
-	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
-	"io"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"os"
 	"os/exec"
 	"path"
 	"path/filepath"
 	"runtime"
+	"sort"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 
 	"golang.org/x/tools/cover"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/jessesomerville/gocov/internal/render"
 )
 
 const usageMessage = "" +
@@ -28,6 +34,24 @@ Provide coverage profile with a flag:
 
 Provide coverage profile on standard input:
 	cat c.out | gocov
+
+Print a per-function coverage summary instead of colorized source:
+	gocov -func c.out
+
+Render an HTML report, opening it in a browser (or writing it to a file):
+	gocov -html c.out
+	gocov -html=cover.html c.out
+
+Emit LCOV or Cobertura XML for CI tooling that consumes those formats:
+	gocov -lcov c.out
+	gocov -cobertura c.out
+
+Merge coverage profiles from multiple test runs before rendering:
+	gocov a.out b.out
+	gocov -merge 'cover-shard-*.out'
+
+Show only coverage of lines changed versus a git ref ("patch coverage"):
+	gocov -diff main c.out
 `
 
 func usage() {
@@ -35,101 +59,508 @@ func usage() {
 	os.Exit(2)
 }
 
+var (
+	funcMode  bool
+	htmlMode  htmlFlag
+	lcovMode  bool
+	cobMode   bool
+	outFile   string
+	rootFlag  string
+	mergeGlob string
+	diffRef   string
+)
+
 func main() {
 	flag.Usage = usage
+	flag.BoolVar(&funcMode, "func", false, "print per-function coverage summary instead of colorized source")
+	flag.Var(&htmlMode, "html", "render an HTML report, opening it in a browser unless `file` is given")
+	flag.BoolVar(&lcovMode, "lcov", false, "emit an LCOV tracefile instead of colorized source")
+	flag.BoolVar(&cobMode, "cobertura", false, "emit Cobertura XML instead of colorized source")
+	flag.StringVar(&rootFlag, "root", "", "path `prefix` to strip from file names in -lcov/-cobertura output")
+	flag.StringVar(&outFile, "o", "", "write output to `file` instead of stdout")
+	flag.StringVar(&mergeGlob, "merge", "", "merge coverage profiles matching `glob` before rendering")
+	flag.StringVar(&diffRef, "diff", "", "only highlight coverage of lines changed versus git `ref`")
 	flag.Parse()
 
-	var rd io.Reader
-	if flag.NArg() == 0 {
-		rd = os.Stdin
-	} else {
-		infile := flag.Arg(0)
-		f, err := os.Open(infile)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "failed to open %q: %v\n", infile, err)
-			os.Exit(2)
-		}
-		rd = f
+	profileSets, err := loadProfileSets(mergeGlob, flag.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
 	}
 
-	profiles, err := cover.ParseProfilesFromReader(rd)
+	profiles, err := mergeProfiles(profileSets)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(2)
 	}
 
-	if err := displayCoverage(profiles); err != nil {
+	switch {
+	case funcMode:
+		err = funcOutput(profiles, outFile)
+	case htmlMode.set:
+		err = htmlOutput(profiles, htmlMode.file)
+	case lcovMode:
+		err = renderCoverage(profiles, render.LCOV{Root: rootFlag}, outFile)
+	case cobMode:
+		err = renderCoverage(profiles, render.Cobertura{Root: rootFlag}, outFile)
+	case diffRef != "":
+		err = diffOutput(profiles, diffRef, outFile)
+	default:
+		err = renderCoverage(profiles, render.Terminal{}, outFile)
+	}
+	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(2)
 	}
 }
 
-var (
-	red    = rgb(48, 26, 31)
-	green  = rgb(18, 38, 30)
-	yellow = rgb(204, 136, 26)
-	dark   = rgb(13, 17, 23)
-)
+// htmlFlag implements flag.Value for "-html" and "-html=file". Unlike
+// 'go tool cover', whose "-html" takes a mandatory profile argument, gocov
+// already knows the profile to render; the flag only needs to say where
+// the report goes, so bare "-html" opens it in a browser and "-html=file"
+// writes it there instead.
+type htmlFlag struct {
+	set  bool
+	file string
+}
+
+func (f *htmlFlag) String() string { return f.file }
+
+func (f *htmlFlag) Set(s string) error {
+	f.set = true
+	if s != "true" {
+		f.file = s
+	}
+	return nil
+}
+
+func (f *htmlFlag) IsBoolFlag() bool { return true }
+
+// loadProfileSets reads the coverage profile(s) to render: the files
+// matched by mergeGlob if it's set, otherwise the files named by args, or
+// a single profile from stdin if args is empty. Each returned slice is
+// the set of profiles parsed from one input file.
+func loadProfileSets(mergeGlob string, args []string) ([][]*cover.Profile, error) {
+	files := args
+	if mergeGlob != "" {
+		matches, err := filepath.Glob(mergeGlob)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -merge glob %q: %v", mergeGlob, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("-merge glob %q matched no files", mergeGlob)
+		}
+		files = matches
+	}
+
+	if len(files) == 0 {
+		profiles, err := cover.ParseProfilesFromReader(os.Stdin)
+		if err != nil {
+			return nil, err
+		}
+		return [][]*cover.Profile{profiles}, nil
+	}
+
+	sets := make([][]*cover.Profile, 0, len(files))
+	for _, file := range files {
+		profiles, err := cover.ParseProfiles(file)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %v", file, err)
+		}
+		sets = append(sets, profiles)
+	}
+	return sets, nil
+}
+
+// mergeProfiles combines profile sets parsed from separate coverage files
+// (e.g. from sharded or per-build-tag test runs) into one []*cover.Profile,
+// summing the Count of matching blocks across inputs. All inputs must
+// share the same Mode ("set" or "count"); in "set" mode the merged Count
+// saturates to 1. The result is sorted by FileName, matching the ordering
+// cover.ParseProfiles already guarantees for a single input file.
+func mergeProfiles(sets [][]*cover.Profile) ([]*cover.Profile, error) {
+	merged := make(map[string]*cover.Profile)
+	var order []string
+	var mode string
+	for _, profiles := range sets {
+		for _, p := range profiles {
+			switch {
+			case mode == "":
+				mode = p.Mode
+			case mode != p.Mode:
+				return nil, fmt.Errorf("cannot merge profiles with different modes (%q vs %q)", mode, p.Mode)
+			}
+			dst, ok := merged[p.FileName]
+			if !ok {
+				dst = &cover.Profile{FileName: p.FileName, Mode: p.Mode}
+				dst.Blocks = append(dst.Blocks, p.Blocks...)
+				merged[p.FileName] = dst
+				order = append(order, p.FileName)
+				continue
+			}
+			if err := mergeBlocks(dst, p.Blocks, mode); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	sort.Strings(order)
+	result := make([]*cover.Profile, len(order))
+	for i, name := range order {
+		result[i] = merged[name]
+	}
+	return result, nil
+}
+
+// blockKey identifies a profile block by its source extent, independent
+// of which input file it came from.
+type blockKey struct {
+	startLine, startCol, endLine, endCol, numStmt int
+}
+
+func keyOf(b cover.ProfileBlock) blockKey {
+	return blockKey{b.StartLine, b.StartCol, b.EndLine, b.EndCol, b.NumStmt}
+}
+
+// mergeBlocks adds blocks' counts into dst.Blocks, matching blocks by
+// their source extent. It returns an error if blocks contains an extent
+// dst doesn't have, which means the inputs were profiled against
+// different source versions and can't be merged meaningfully.
+func mergeBlocks(dst *cover.Profile, blocks []cover.ProfileBlock, mode string) error {
+	index := make(map[blockKey]int, len(dst.Blocks))
+	for i, b := range dst.Blocks {
+		index[keyOf(b)] = i
+	}
+	for _, b := range blocks {
+		i, ok := index[keyOf(b)]
+		if !ok {
+			return fmt.Errorf("%s: profile blocks don't line up across inputs; were they built from different source versions?", dst.FileName)
+		}
+		dst.Blocks[i].Count += b.Count
+		if mode == "set" && dst.Blocks[i].Count > 1 {
+			dst.Blocks[i].Count = 1
+		}
+	}
+	return nil
+}
+
+// funcOutput writes a per-function coverage summary for profiles, in the
+// classic "file.go:line: FuncName  87.5%" format used by 'go tool cover
+// -func', ending with a "total: (statements) N%" line. If outFile is
+// non-empty the summary is written there instead of to stdout.
+func funcOutput(profiles []*cover.Profile, outFile string) error {
+	w := os.Stdout
+	if outFile != "" {
+		f, err := os.Create(outFile)
+		if err != nil {
+			return fmt.Errorf("can't create %q: %v", outFile, err)
+		}
+		defer f.Close()
+		w = f
+	}
 
-func displayCoverage(profiles []*cover.Profile) error {
 	dirs, err := findPkgs(profiles)
 	if err != nil {
 		return err
 	}
+
+	tw := tabwriter.NewWriter(w, 1, 8, 1, '\t', 0)
+	defer tw.Flush()
+
+	var total, covered int64
 	for _, profile := range profiles {
 		fn := profile.FileName
 		file, err := findFile(dirs, fn)
 		if err != nil {
 			return err
 		}
+		funcs, err := findFuncs(file)
+		if err != nil {
+			return fmt.Errorf("can't parse %q: %v", fn, err)
+		}
+		for _, f := range funcs {
+			c, t := f.coverage(profile.Blocks)
+			fmt.Fprintf(tw, "%s:%d:\t%s\t%s\n", fn, f.startLine, f.name, percentage(c, t))
+			total += t
+			covered += c
+		}
+	}
+	fmt.Fprintf(tw, "total:\t(statements)\t%s\n", percentage(covered, total))
+	return nil
+}
+
+// funcExtent describes the source extent of a single function or method
+// declaration, as found by findFuncs.
+type funcExtent struct {
+	name                string
+	startLine, startCol int
+	endLine, endCol     int
+}
+
+// coverage sums the NumStmt of every block that falls within f's extent,
+// weighting blocks with Count>0 into the covered total. Functions spanning
+// multiple profile blocks are handled by summing across all of them.
+func (f *funcExtent) coverage(blocks []cover.ProfileBlock) (covered, total int64) {
+	for _, b := range blocks {
+		if b.StartLine > f.endLine || (b.StartLine == f.endLine && b.StartCol >= f.endCol) {
+			// Block starts after the function ends.
+			continue
+		}
+		if b.EndLine < f.startLine || (b.EndLine == f.startLine && b.EndCol <= f.startCol) {
+			// Block ends before the function starts.
+			continue
+		}
+		total += int64(b.NumStmt)
+		if b.Count > 0 {
+			covered += int64(b.NumStmt)
+		}
+	}
+	return covered, total
+}
+
+// findFuncs parses the Go source file named by filename and returns the
+// extent of every function and method with a body declared in it, in
+// source order.
+func findFuncs(filename string) ([]*funcExtent, error) {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, filename, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	var funcs []*funcExtent
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		fd, ok := n.(*ast.FuncDecl)
+		if !ok {
+			return true
+		}
+		if fd.Body == nil {
+			// Assembly-only declaration (e.g. //go:noescape backed by a
+			// .s file); there's no source block for it to ever get
+			// covered, so go tool cover never emits one either.
+			return true
+		}
+		start := fset.Position(fd.Pos())
+		end := fset.Position(fd.End())
+		funcs = append(funcs, &funcExtent{
+			name:      funcName(fd),
+			startLine: start.Line,
+			startCol:  start.Column,
+			endLine:   end.Line,
+			endCol:    end.Column,
+		})
+		return true
+	})
+	return funcs, nil
+}
+
+// funcName returns fd's name, prefixed with its receiver type for methods:
+// "(*Foo).Bar" for a pointer receiver, "Foo.Bar" for a value receiver.
+func funcName(fd *ast.FuncDecl) string {
+	name := fd.Name.Name
+	if fd.Recv == nil || fd.Recv.NumFields() == 0 {
+		return name
+	}
+	switch typ := fd.Recv.List[0].Type.(type) {
+	case *ast.StarExpr:
+		return "(*" + typ.X.(*ast.Ident).Name + ")." + name
+	case *ast.Ident:
+		return typ.Name + "." + name
+	}
+	return name
+}
+
+// percentage formats covered/total as a percentage string, or "0.0%" when
+// total is zero.
+func percentage(covered, total int64) string {
+	if total == 0 {
+		return "0.0%"
+	}
+	return fmt.Sprintf("%.1f%%", 100*float64(covered)/float64(total))
+}
+
+// renderCoverage resolves each profiled file's source, builds the
+// []render.File the renderers expect, and writes r's report to outFile
+// (or stdout if outFile is empty).
+func renderCoverage(profiles []*cover.Profile, r render.Renderer, outFile string) error {
+	w := os.Stdout
+	if outFile != "" {
+		f, err := os.Create(outFile)
+		if err != nil {
+			return fmt.Errorf("can't create %q: %v", outFile, err)
+		}
+		defer f.Close()
+		w = f
+	}
+	files, err := loadFiles(profiles)
+	if err != nil {
+		return err
+	}
+	return r.Render(w, files)
+}
+
+// htmlOutput renders profiles as HTML. If file is empty, the report is
+// written to a temporary file and opened in the user's default browser,
+// as 'go tool cover -html' does; otherwise it's written to file.
+func htmlOutput(profiles []*cover.Profile, file string) error {
+	if file != "" {
+		return renderCoverage(profiles, render.HTML{}, file)
+	}
+	f, err := os.CreateTemp("", "gocov*.html")
+	if err != nil {
+		return fmt.Errorf("creating temp file: %v", err)
+	}
+	defer f.Close()
+	files, err := loadFiles(profiles)
+	if err != nil {
+		return err
+	}
+	if err := (render.HTML{}).Render(f, files); err != nil {
+		return err
+	}
+	return startBrowser(f.Name())
+}
+
+// loadFiles resolves each profile's source file on disk and pairs it with
+// its coverage blocks, ready for a render.Renderer.
+func loadFiles(profiles []*cover.Profile) ([]render.File, error) {
+	dirs, err := findPkgs(profiles)
+	if err != nil {
+		return nil, err
+	}
+	var files []render.File
+	for _, profile := range profiles {
+		fn := profile.FileName
+		file, err := findFile(dirs, fn)
+		if err != nil {
+			return nil, err
+		}
 		src, err := os.ReadFile(file)
 		if err != nil {
-			return fmt.Errorf("can't read %q: %v", fn, err)
+			return nil, fmt.Errorf("can't read %q: %v", fn, err)
 		}
-		fmt.Printf("\n%s\n", yellow.Fg([]byte(fn)))
+		files = append(files, render.File{Name: fn, Path: file, Src: src, Blocks: profile.Blocks})
+	}
+	return files, nil
+}
 
-		colorlines(src, profile.Blocks)
+// startBrowser opens url in the user's default browser.
+func startBrowser(url string) error {
+	var args []string
+	switch runtime.GOOS {
+	case "darwin":
+		args = []string{"open"}
+	case "windows":
+		args = []string{"cmd", "/c", "start"}
+	default:
+		args = []string{"xdg-open"}
 	}
-	return nil
+	return exec.Command(args[0], append(args[1:], url)...).Start()
 }
 
-func colorlines(src []byte, blocks []cover.ProfileBlock) {
-	// Replace tabs with two spaces.
-	src = bytes.ReplaceAll(src, []byte{9}, []byte("  "))
-	prevEnd := 0
-	var curr []byte
-	for _, block := range blocks {
-		curr, src = cutAfterIndexN(src, '\n', block.StartLine-prevEnd)
-		fmt.Printf("%s", curr) // Uninstrumented lines.
-		curr, src = cutAfterIndexN(src, '\n', block.EndLine-block.StartLine)
-		if block.Count == 0 {
-			fmt.Printf(red.Bg(curr))
-		} else {
-			fmt.Printf(green.Bg(curr))
+// diffOutput renders profiles with shading restricted to lines changed
+// versus ref (dimming the rest), analogous to Codecov's patch coverage,
+// followed by a covered-vs-uncovered summary of the changed statements in
+// each file.
+func diffOutput(profiles []*cover.Profile, ref, outFile string) error {
+	w := os.Stdout
+	if outFile != "" {
+		f, err := os.Create(outFile)
+		if err != nil {
+			return fmt.Errorf("can't create %q: %v", outFile, err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	files, err := loadFiles(profiles)
+	if err != nil {
+		return err
+	}
+	for i, f := range files {
+		changed, err := changedLines(ref, f.Path)
+		if err != nil {
+			return err
 		}
-		prevEnd = block.EndLine
+		files[i].ChangedLines = changed
+	}
+
+	if err := (render.Terminal{}).Render(w, files); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w)
+	for _, f := range files {
+		covered, total := changedCoverage(f)
+		fmt.Fprintf(w, "%s: %s of changed statements covered (%d/%d)\n", f.Name, percentage(covered, total), covered, total)
+	}
+	return nil
+}
+
+// changedLines runs 'git diff --unified=0 ref -- path' and returns the set
+// of line numbers the diff added or modified in path's current version.
+// path must be the file's on-disk location, not its import-path-qualified
+// profile name - git doesn't know how to match the latter as a pathspec.
+func changedLines(ref, path string) (map[int]bool, error) {
+	cmd := exec.Command("git", "diff", "--unified=0", ref, "--", path)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git diff %s -- %s: %v", ref, path, err)
 	}
-	if len(src) != 0 {
-		fmt.Printf("%s%s\n", reset, src)
+	return parseHunkLines(out), nil
+}
+
+// parseHunkLines extracts the added/modified line numbers from unified
+// diff hunk headers of the form "@@ -a,b +c,d @@".
+func parseHunkLines(diff []byte) map[int]bool {
+	lines := make(map[int]bool)
+	for _, line := range strings.Split(string(diff), "\n") {
+		if !strings.HasPrefix(line, "@@ ") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			continue
+		}
+		start, count := parseHunkRange(strings.TrimPrefix(fields[2], "+"))
+		for l := start; l < start+count; l++ {
+			lines[l] = true
+		}
 	}
+	return lines
 }
 
-func cutAfterIndexN(s []byte, sep byte, n int) (before, after []byte) {
-	if n <= 0 {
-		return s, nil
+// parseHunkRange parses the "c,d" or "c" half of a hunk header into a
+// starting line and line count. A missing count means exactly one line.
+func parseHunkRange(spec string) (start, count int) {
+	parts := strings.SplitN(spec, ",", 2)
+	start, _ = strconv.Atoi(parts[0])
+	count = 1
+	if len(parts) == 2 {
+		count, _ = strconv.Atoi(parts[1])
 	}
-	i := 0
-	for i < len(s)-1 {
-		if s[i] == sep {
-			n--
-			if n == 0 {
+	return start, count
+}
+
+// changedCoverage sums the NumStmt of every block in f that touches a
+// changed line, weighting blocks with Count>0 into the covered total.
+func changedCoverage(f render.File) (covered, total int64) {
+	for _, b := range f.Blocks {
+		touched := false
+		for line := b.StartLine; line <= b.EndLine; line++ {
+			if f.ChangedLines[line] {
+				touched = true
 				break
 			}
 		}
-		i++
+		if !touched {
+			continue
+		}
+		total += int64(b.NumStmt)
+		if b.Count > 0 {
+			covered += int64(b.NumStmt)
+		}
 	}
-	i++
-	return s[:i], s[i:]
+	return covered, total
 }
 
 // Pkg describes a single package, compatible with JSON output from 'go list'.
@@ -142,9 +573,11 @@ type Pkg struct {
 }
 
 func findPkgs(profiles []*cover.Profile) (map[string]*Pkg, error) {
-	// Run go list to find the location of every package we care about.
+	// Use packages.Load to find the location of every package we care
+	// about; unlike a hand-rolled "go list -json" this resolves correctly
+	// for nested modules, vendored trees, and go.work workspaces.
 	pkgs := make(map[string]*Pkg)
-	var list []string
+	var patterns []string
 	for _, profile := range profiles {
 		if strings.HasPrefix(profile.FileName, ".") || filepath.IsAbs(profile.FileName) {
 			// Relative or absolute path.
@@ -153,39 +586,49 @@ func findPkgs(profiles []*cover.Profile) (map[string]*Pkg, error) {
 		pkg := path.Dir(profile.FileName)
 		if _, ok := pkgs[pkg]; !ok {
 			pkgs[pkg] = nil
-			list = append(list, pkg)
+			patterns = append(patterns, pkg)
 		}
 	}
 
-	if len(list) == 0 {
+	if len(patterns) == 0 {
 		return pkgs, nil
 	}
 
-	// Note: usually run as "go tool cover" in which case $GOROOT is set,
-	// in which case runtime.GOROOT() does exactly what we want.
-	goTool := filepath.Join(runtime.GOROOT(), "bin/go")
-	cmd := exec.Command(goTool, append([]string{"list", "-e", "-json"}, list...)...)
-	var stderr bytes.Buffer
-	cmd.Stderr = &stderr
-	stdout, err := cmd.Output()
+	cfg := &packages.Config{Mode: packages.NeedName | packages.NeedFiles | packages.NeedModule}
+	loaded, err := packages.Load(cfg, patterns...)
 	if err != nil {
-		return nil, fmt.Errorf("cannot run go list: %v\n%s", err, stderr.Bytes())
+		return nil, fmt.Errorf("cannot load packages: %v", err)
 	}
-	dec := json.NewDecoder(bytes.NewReader(stdout))
-	for {
-		var pkg Pkg
-		err := dec.Decode(&pkg)
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return nil, fmt.Errorf("decoding go list json: %v", err)
-		}
-		pkgs[pkg.ImportPath] = &pkg
+	for _, p := range loaded {
+		pkgs[p.PkgPath] = pkgFromLoaded(p)
 	}
 	return pkgs, nil
 }
 
+// pkgFromLoaded converts a single loaded package into a *Pkg, surfacing
+// its load errors rather than the whole run's when it failed to resolve.
+func pkgFromLoaded(p *packages.Package) *Pkg {
+	pkg := &Pkg{ImportPath: p.PkgPath}
+	switch {
+	case len(p.Errors) > 0:
+		msgs := make([]string, len(p.Errors))
+		for i, e := range p.Errors {
+			msgs[i] = e.Error()
+		}
+		pkg.Error = &struct{ Err string }{Err: strings.Join(msgs, "; ")}
+	case len(p.GoFiles) > 0:
+		pkg.Dir = filepath.Dir(p.GoFiles[0])
+	default:
+		// No Go files and no load error - e.g. every file was excluded
+		// by build constraints for this GOOS/GOARCH, or the package
+		// has only test files. p.Module.Dir is the module root, not
+		// this package's directory, so treat it as unresolved rather
+		// than pointing findFile at the wrong place.
+		pkg.Error = &struct{ Err string }{Err: fmt.Sprintf("package %s has no Go files for this platform", p.PkgPath)}
+	}
+	return pkg
+}
+
 func findFile(pkgs map[string]*Pkg, file string) (string, error) {
 	if strings.HasPrefix(file, ".") || filepath.IsAbs(file) {
 		return file, nil
@@ -201,25 +644,3 @@ func findFile(pkgs map[string]*Pkg, file string) (string, error) {
 	}
 	return "", fmt.Errorf("did not find package for %s in go list output", file)
 }
-
-const reset = "\x1b[0m"
-
-type trueColor struct {
-	R, G, B uint8
-}
-
-func rgb(r, g, b uint8) trueColor {
-	return trueColor{r, g, b}
-}
-
-func (tc trueColor) String() string {
-	return fmt.Sprintf("rgb(%d, %d, %d)", tc.R, tc.G, tc.B)
-}
-
-func (tc trueColor) Bg(msg []byte) string {
-	return fmt.Sprintf("\x1b[48;2;%d;%d;%dm%s%s", tc.R, tc.G, tc.B, msg, reset)
-}
-
-func (tc trueColor) Fg(msg []byte) string {
-	return fmt.Sprintf("\x1b[38;2;%d;%d;%dm%s%s", tc.R, tc.G, tc.B, msg, reset)
-}
@@ -0,0 +1,115 @@
+package render
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"time"
+)
+
+// Cobertura renders coverage as Cobertura XML, the format Jenkins' Cobertura
+// plugin and many other CI tools consume. Root, if set, is stripped from
+// the front of every file name in the report.
+type Cobertura struct {
+	Root string
+}
+
+func (c Cobertura) Render(w io.Writer, files []File) error {
+	pkgs := make(map[string]*coberturaPackage)
+	var pkgOrder []string
+	var totalLines, totalHit int
+
+	for _, f := range files {
+		name := stripRoot(f.Name, c.Root)
+		pkgName := path.Dir(name)
+		pkg, ok := pkgs[pkgName]
+		if !ok {
+			pkg = &coberturaPackage{Name: pkgName}
+			pkgs[pkgName] = pkg
+			pkgOrder = append(pkgOrder, pkgName)
+		}
+
+		counts := lineCounts(f.Blocks)
+		lineNums := make([]int, 0, len(counts))
+		for line := range counts {
+			lineNums = append(lineNums, line)
+		}
+		sort.Ints(lineNums)
+
+		class := coberturaClass{Name: path.Base(name), Filename: name}
+		var hit int
+		for _, line := range lineNums {
+			count := counts[line]
+			if count > 0 {
+				hit++
+			}
+			class.Lines = append(class.Lines, coberturaLine{Number: line, Hits: count})
+		}
+		class.LineRate = rate(hit, len(lineNums))
+		pkg.Classes = append(pkg.Classes, class)
+		pkg.linesCovered += hit
+		pkg.linesValid += len(lineNums)
+		totalLines += len(lineNums)
+		totalHit += hit
+	}
+
+	coverage := coberturaCoverage{
+		LineRate:     rate(totalHit, totalLines),
+		LinesCovered: totalHit,
+		LinesValid:   totalLines,
+		Timestamp:    time.Now().Unix(),
+	}
+	for _, name := range pkgOrder {
+		pkg := pkgs[name]
+		pkg.LineRate = rate(pkg.linesCovered, pkg.linesValid)
+		coverage.Packages = append(coverage.Packages, *pkg)
+	}
+
+	fmt.Fprint(w, xml.Header)
+	fmt.Fprint(w, `<!DOCTYPE coverage SYSTEM "http://cobertura.sourceforge.net/xml/coverage-04.dtd">`+"\n")
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(coverage); err != nil {
+		return fmt.Errorf("encoding cobertura xml: %v", err)
+	}
+	fmt.Fprintln(w)
+	return nil
+}
+
+func rate(hit, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(hit) / float64(total)
+}
+
+type coberturaCoverage struct {
+	XMLName      xml.Name           `xml:"coverage"`
+	LineRate     float64            `xml:"line-rate,attr"`
+	LinesCovered int                `xml:"lines-covered,attr"`
+	LinesValid   int                `xml:"lines-valid,attr"`
+	Timestamp    int64              `xml:"timestamp,attr"`
+	Packages     []coberturaPackage `xml:"packages>package"`
+}
+
+type coberturaPackage struct {
+	Name         string           `xml:"name,attr"`
+	LineRate     float64          `xml:"line-rate,attr"`
+	Classes      []coberturaClass `xml:"classes>class"`
+	linesCovered int
+	linesValid   int
+}
+
+type coberturaClass struct {
+	Name     string          `xml:"name,attr"`
+	Filename string          `xml:"filename,attr"`
+	LineRate float64         `xml:"line-rate,attr"`
+	Lines    []coberturaLine `xml:"lines>line"`
+}
+
+type coberturaLine struct {
+	Number int `xml:"number,attr"`
+	Hits   int `xml:"hits,attr"`
+}
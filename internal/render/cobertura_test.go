@@ -0,0 +1,85 @@
+package render
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"golang.org/x/tools/cover"
+)
+
+func TestCoberturaRender(t *testing.T) {
+	files := []File{
+		{
+			Name: "github.com/foo/bar/baz.go",
+			Blocks: []cover.ProfileBlock{
+				{StartLine: 1, EndLine: 1, NumStmt: 1, Count: 1},
+				{StartLine: 2, EndLine: 2, NumStmt: 1, Count: 0},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := (Cobertura{}).Render(&buf, files); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	var got coberturaCoverage
+	if err := xml.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("unmarshaling rendered xml: %v\n%s", err, buf.String())
+	}
+
+	if got.LinesValid != 2 || got.LinesCovered != 1 {
+		t.Errorf("coverage totals = valid %d, covered %d; want 2, 1", got.LinesValid, got.LinesCovered)
+	}
+	if len(got.Packages) != 1 {
+		t.Fatalf("Packages = %v, want exactly 1", got.Packages)
+	}
+	pkg := got.Packages[0]
+	if pkg.Name != "github.com/foo/bar" {
+		t.Errorf("Package.Name = %q, want %q", pkg.Name, "github.com/foo/bar")
+	}
+	if len(pkg.Classes) != 1 || pkg.Classes[0].Filename != "github.com/foo/bar/baz.go" {
+		t.Fatalf("Package.Classes = %v", pkg.Classes)
+	}
+	class := pkg.Classes[0]
+	if len(class.Lines) != 2 || class.Lines[0].Hits != 1 || class.Lines[1].Hits != 0 {
+		t.Errorf("Class.Lines = %v, want [{1 hits=1} {2 hits=0}]", class.Lines)
+	}
+}
+
+func TestCoberturaRenderGroupsPackagesByDir(t *testing.T) {
+	files := []File{
+		{Name: "github.com/foo/a/x.go", Blocks: []cover.ProfileBlock{{StartLine: 1, EndLine: 1, NumStmt: 1, Count: 1}}},
+		{Name: "github.com/foo/b/y.go", Blocks: []cover.ProfileBlock{{StartLine: 1, EndLine: 1, NumStmt: 1, Count: 1}}},
+	}
+
+	var buf bytes.Buffer
+	if err := (Cobertura{}).Render(&buf, files); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	var got coberturaCoverage
+	if err := xml.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("unmarshaling rendered xml: %v", err)
+	}
+	if len(got.Packages) != 2 {
+		t.Fatalf("Packages = %v, want 2 distinct packages", got.Packages)
+	}
+}
+
+func TestRate(t *testing.T) {
+	tests := []struct {
+		hit, total int
+		want       float64
+	}{
+		{0, 0, 0},
+		{1, 2, 0.5},
+		{2, 2, 1},
+	}
+	for _, tt := range tests {
+		if got := rate(tt.hit, tt.total); got != tt.want {
+			t.Errorf("rate(%d, %d) = %v, want %v", tt.hit, tt.total, got, tt.want)
+		}
+	}
+}
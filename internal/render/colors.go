@@ -0,0 +1,37 @@
+package render
+
+import "fmt"
+
+const reset = "\x1b[0m"
+
+var (
+	red    = rgb(48, 26, 31)
+	green  = rgb(18, 38, 30)
+	yellow = rgb(204, 136, 26)
+	dark   = rgb(13, 17, 23)
+)
+
+type trueColor struct {
+	R, G, B uint8
+}
+
+func rgb(r, g, b uint8) trueColor {
+	return trueColor{r, g, b}
+}
+
+func (tc trueColor) String() string {
+	return fmt.Sprintf("rgb(%d, %d, %d)", tc.R, tc.G, tc.B)
+}
+
+func (tc trueColor) Bg(msg []byte) string {
+	return fmt.Sprintf("\x1b[48;2;%d;%d;%dm%s%s", tc.R, tc.G, tc.B, msg, reset)
+}
+
+func (tc trueColor) Fg(msg []byte) string {
+	return fmt.Sprintf("\x1b[38;2;%d;%d;%dm%s%s", tc.R, tc.G, tc.B, msg, reset)
+}
+
+// Hex renders tc as a CSS hex color, for use by the HTML renderer.
+func (tc trueColor) Hex() string {
+	return fmt.Sprintf("#%02x%02x%02x", tc.R, tc.G, tc.B)
+}
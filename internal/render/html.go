@@ -0,0 +1,106 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"golang.org/x/tools/cover"
+)
+
+// HTML renders coverage as a single self-contained HTML page: one section
+// per file with coverage-shaded source, plus a sidebar linking to every
+// file along with its overall statement coverage.
+type HTML struct{}
+
+func (HTML) Render(w io.Writer, files []File) error {
+	fmt.Fprintf(w, htmlHeader, dark.Hex())
+
+	fmt.Fprint(w, "<nav id=\"files\">\n<ul>\n")
+	for _, f := range files {
+		covered, total := blockTotals(f.Blocks)
+		fmt.Fprintf(w, "<li><a href=\"#%s\">%s</a> <span class=\"pct\">%s</span></li>\n",
+			anchor(f.Name), html.EscapeString(f.Name), percentage(covered, total))
+	}
+	fmt.Fprint(w, "</ul>\n</nav>\n<main>\n")
+
+	for _, f := range files {
+		fmt.Fprintf(w, "<h2 id=\"%s\">%s</h2>\n<pre>", anchor(f.Name), html.EscapeString(f.Name))
+		writeBlocks(w, f.Src, f.Blocks)
+		fmt.Fprint(w, "</pre>\n")
+	}
+
+	fmt.Fprint(w, "</main>\n</body>\n</html>\n")
+	return nil
+}
+
+func writeBlocks(w io.Writer, src []byte, blocks []cover.ProfileBlock) {
+	prevEnd := 0
+	var curr []byte
+	for _, block := range blocks {
+		curr, src = cutAfterIndexN(src, '\n', block.StartLine-prevEnd)
+		fmt.Fprint(w, html.EscapeString(string(curr)))
+		curr, src = cutAfterIndexN(src, '\n', block.EndLine-block.StartLine)
+		class := "miss"
+		if block.Count > 0 {
+			class = "hit"
+		}
+		fmt.Fprintf(w, "<span class=\"%s\">%s</span>", class, html.EscapeString(string(curr)))
+		prevEnd = block.EndLine
+	}
+	if len(src) != 0 {
+		fmt.Fprint(w, html.EscapeString(string(src)))
+	}
+}
+
+func blockTotals(blocks []cover.ProfileBlock) (covered, total int64) {
+	for _, b := range blocks {
+		total += int64(b.NumStmt)
+		if b.Count > 0 {
+			covered += int64(b.NumStmt)
+		}
+	}
+	return covered, total
+}
+
+func percentage(covered, total int64) string {
+	if total == 0 {
+		return "0.0%"
+	}
+	return fmt.Sprintf("%.1f%%", 100*float64(covered)/float64(total))
+}
+
+// anchor turns a profile file name into a value safe for use as an HTML id.
+func anchor(name string) string {
+	var b bytes.Buffer
+	for _, r := range name {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('-')
+		}
+	}
+	return strings.Trim(b.String(), "-")
+}
+
+const htmlHeader = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>gocov</title>
+<style>
+  body { background: %s; color: #c9d1d9; font-family: sans-serif; display: flex; margin: 0; }
+  nav#files { width: 320px; flex: none; padding: 1em; overflow-y: auto; border-right: 1px solid #30363d; }
+  nav#files ul { list-style: none; margin: 0; padding: 0; }
+  nav#files li { white-space: nowrap; overflow: hidden; text-overflow: ellipsis; }
+  nav#files .pct { color: #8b949e; float: right; }
+  main { flex: auto; padding: 1em; overflow-x: auto; }
+  pre { white-space: pre; font-family: monospace; }
+  span.hit { background: #12261e; }
+  span.miss { background: #301a1f; }
+</style>
+</head>
+<body>
+`
@@ -0,0 +1,65 @@
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/cover"
+)
+
+func TestHTMLRender(t *testing.T) {
+	src := "package p\n\nfunc F() {\n\t_ = 1\n}\n\nfunc G() {\n\t_ = 2\n}\n"
+	files := []File{
+		{
+			Name: "github.com/foo/bar/baz.go",
+			Src:  []byte(src),
+			Blocks: []cover.ProfileBlock{
+				{StartLine: 3, EndLine: 5, NumStmt: 1, Count: 1},
+				{StartLine: 7, EndLine: 9, NumStmt: 1, Count: 0},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := (HTML{}).Render(&buf, files); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	got := buf.String()
+
+	for _, want := range []string{
+		`<a href="#` + anchor("github.com/foo/bar/baz.go") + `">`,
+		`<span class="pct">50.0%</span>`,
+		"<span class=\"hit\">\t_ = 1\n}\n</span>",
+		"<span class=\"miss\">\t_ = 2\n}\n</span>",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Render() missing %q in output:\n%s", want, got)
+		}
+	}
+}
+
+func TestBlockTotals(t *testing.T) {
+	blocks := []cover.ProfileBlock{
+		{NumStmt: 2, Count: 1},
+		{NumStmt: 3, Count: 0},
+	}
+	covered, total := blockTotals(blocks)
+	if covered != 2 || total != 5 {
+		t.Errorf("blockTotals() = (%d, %d), want (2, 5)", covered, total)
+	}
+}
+
+func TestAnchor(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"github.com/foo/bar.go", "github-com-foo-bar-go"},
+		{"-leading-and-trailing-", "leading-and-trailing"},
+	}
+	for _, tt := range tests {
+		if got := anchor(tt.name); got != tt.want {
+			t.Errorf("anchor(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/cover"
+)
+
+// LCOV renders coverage as an LCOV tracefile, the format consumed by
+// Codecov, Coveralls, and most other CI coverage tooling. Root, if set,
+// is stripped from the front of every file name in the report.
+type LCOV struct {
+	Root string
+}
+
+func (l LCOV) Render(w io.Writer, files []File) error {
+	for _, f := range files {
+		fmt.Fprintf(w, "SF:%s\n", stripRoot(f.Name, l.Root))
+
+		counts := lineCounts(f.Blocks)
+		lines := make([]int, 0, len(counts))
+		for line := range counts {
+			lines = append(lines, line)
+		}
+		sort.Ints(lines)
+
+		var hit int
+		for _, line := range lines {
+			count := counts[line]
+			fmt.Fprintf(w, "DA:%d,%d\n", line, count)
+			if count > 0 {
+				hit++
+			}
+		}
+		fmt.Fprintf(w, "LF:%d\n", len(lines))
+		fmt.Fprintf(w, "LH:%d\n", hit)
+		fmt.Fprint(w, "end_of_record\n")
+	}
+	return nil
+}
+
+// lineCounts expands blocks into a per-line hit count. Profile blocks
+// cover a contiguous range of lines, so every line in that range shares
+// the block's Count.
+func lineCounts(blocks []cover.ProfileBlock) map[int]int {
+	counts := make(map[int]int)
+	for _, b := range blocks {
+		for line := b.StartLine; line <= b.EndLine; line++ {
+			if c, ok := counts[line]; !ok || b.Count > c {
+				counts[line] = b.Count
+			}
+		}
+	}
+	return counts
+}
+
+// stripRoot removes root, if present, from the front of name, along with
+// any separating slash.
+func stripRoot(name, root string) string {
+	if root == "" {
+		return name
+	}
+	trimmed := strings.TrimPrefix(name, root)
+	if trimmed == name {
+		return name
+	}
+	return strings.TrimPrefix(trimmed, "/")
+}
@@ -0,0 +1,84 @@
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/cover"
+)
+
+func TestLCOVRender(t *testing.T) {
+	files := []File{
+		{
+			Name: "github.com/foo/bar/baz.go",
+			Blocks: []cover.ProfileBlock{
+				{StartLine: 1, EndLine: 2, NumStmt: 1, Count: 1},
+				{StartLine: 3, EndLine: 3, NumStmt: 1, Count: 0},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := (LCOV{}).Render(&buf, files); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	want := "SF:github.com/foo/bar/baz.go\n" +
+		"DA:1,1\n" +
+		"DA:2,1\n" +
+		"DA:3,0\n" +
+		"LF:3\n" +
+		"LH:2\n" +
+		"end_of_record\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Render() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestLCOVRenderStripsRoot(t *testing.T) {
+	files := []File{{Name: "github.com/foo/bar/baz.go"}}
+
+	var buf bytes.Buffer
+	if err := (LCOV{Root: "github.com/foo/bar"}).Render(&buf, files); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	if got := buf.String(); !strings.HasPrefix(got, "SF:baz.go\n") {
+		t.Errorf("Render() = %q, want SF line stripped of root prefix", got)
+	}
+}
+
+func TestLineCounts(t *testing.T) {
+	blocks := []cover.ProfileBlock{
+		{StartLine: 1, EndLine: 3, NumStmt: 1, Count: 1},
+		// Overlaps the line above; the higher count should win.
+		{StartLine: 3, EndLine: 3, NumStmt: 1, Count: 5},
+	}
+
+	got := lineCounts(blocks)
+	want := map[int]int{1: 1, 2: 1, 3: 5}
+	for line, count := range want {
+		if got[line] != count {
+			t.Errorf("lineCounts()[%d] = %d, want %d", line, got[line], count)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("lineCounts() = %v, want %v", got, want)
+	}
+}
+
+func TestStripRoot(t *testing.T) {
+	tests := []struct {
+		name, root, want string
+	}{
+		{"github.com/foo/bar/baz.go", "github.com/foo/bar", "baz.go"},
+		{"github.com/foo/bar/baz.go", "", "github.com/foo/bar/baz.go"},
+		{"github.com/foo/bar/baz.go", "github.com/other", "github.com/foo/bar/baz.go"},
+	}
+	for _, tt := range tests {
+		if got := stripRoot(tt.name, tt.root); got != tt.want {
+			t.Errorf("stripRoot(%q, %q) = %q, want %q", tt.name, tt.root, got, tt.want)
+		}
+	}
+}
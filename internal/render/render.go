@@ -0,0 +1,34 @@
+// Package render turns a parsed coverage profile into a human-readable
+// report. Each output format (true-color terminal, HTML, LCOV and
+// Cobertura) implements the Renderer interface so gocov's main
+// package can pick one without knowing its details.
+package render
+
+import (
+	"io"
+
+	"golang.org/x/tools/cover"
+)
+
+// File is a single profiled source file, paired with the original source
+// it was compiled from so a Renderer can shade it line by line.
+type File struct {
+	// Name is the profile's reported name for this file (import-path
+	// qualified, e.g. "github.com/foo/bar/baz.go"). Renderers use this
+	// for display and in their output.
+	Name string
+	// Path is where Src was actually read from on disk.
+	Path   string
+	Src    []byte
+	Blocks []cover.ProfileBlock
+
+	// ChangedLines restricts coverage shading to lines present in this
+	// set, dimming every other block; nil means shade every block
+	// normally. Used by diff mode to show "coverage of my PR".
+	ChangedLines map[int]bool
+}
+
+// Renderer writes a coverage report for files to w.
+type Renderer interface {
+	Render(w io.Writer, files []File) error
+}
@@ -0,0 +1,78 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"golang.org/x/tools/cover"
+)
+
+// Terminal renders coverage as true-color-shaded source, the classic
+// gocov output: covered statements on a green background, uncovered ones
+// on red. It's the default renderer when stdout is a terminal.
+type Terminal struct{}
+
+func (Terminal) Render(w io.Writer, files []File) error {
+	for _, f := range files {
+		fmt.Fprintf(w, "\n%s\n", yellow.Fg([]byte(f.Name)))
+		colorlines(w, f.Src, f.Blocks, f.ChangedLines)
+	}
+	return nil
+}
+
+// colorlines prints src with each block shaded green (covered) or red
+// (uncovered). If changed is non-nil, blocks that don't touch any of its
+// lines are dimmed instead, regardless of coverage - used by diff mode to
+// draw attention to only the lines a change actually touched.
+func colorlines(w io.Writer, src []byte, blocks []cover.ProfileBlock, changed map[int]bool) {
+	// Replace tabs with two spaces.
+	src = bytes.ReplaceAll(src, []byte{9}, []byte("  "))
+	prevEnd := 0
+	var curr []byte
+	for _, block := range blocks {
+		curr, src = cutAfterIndexN(src, '\n', block.StartLine-prevEnd)
+		fmt.Fprintf(w, "%s", curr) // Uninstrumented lines.
+		curr, src = cutAfterIndexN(src, '\n', block.EndLine-block.StartLine)
+		switch {
+		case changed != nil && !blockChanged(block, changed):
+			fmt.Fprint(w, dark.Bg(curr))
+		case block.Count == 0:
+			fmt.Fprint(w, red.Bg(curr))
+		default:
+			fmt.Fprint(w, green.Bg(curr))
+		}
+		prevEnd = block.EndLine
+	}
+	if len(src) != 0 {
+		fmt.Fprintf(w, "%s%s\n", reset, src)
+	}
+}
+
+// blockChanged reports whether any line in block's range is in changed.
+func blockChanged(block cover.ProfileBlock, changed map[int]bool) bool {
+	for line := block.StartLine; line <= block.EndLine; line++ {
+		if changed[line] {
+			return true
+		}
+	}
+	return false
+}
+
+func cutAfterIndexN(s []byte, sep byte, n int) (before, after []byte) {
+	if n <= 0 {
+		return s, nil
+	}
+	i := 0
+	for i < len(s)-1 {
+		if s[i] == sep {
+			n--
+			if n == 0 {
+				break
+			}
+		}
+		i++
+	}
+	i++
+	return s[:i], s[i:]
+}
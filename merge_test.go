@@ -0,0 +1,78 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/tools/cover"
+)
+
+func block(start, end, numStmt, count int) cover.ProfileBlock {
+	return cover.ProfileBlock{StartLine: start, EndLine: end, NumStmt: numStmt, Count: count}
+}
+
+func TestMergeProfilesSumsCounts(t *testing.T) {
+	sets := [][]*cover.Profile{
+		{{FileName: "a.go", Mode: "count", Blocks: []cover.ProfileBlock{block(1, 2, 1, 3)}}},
+		{{FileName: "a.go", Mode: "count", Blocks: []cover.ProfileBlock{block(1, 2, 1, 4)}}},
+	}
+
+	merged, err := mergeProfiles(sets)
+	if err != nil {
+		t.Fatalf("mergeProfiles: %v", err)
+	}
+	if len(merged) != 1 || merged[0].Blocks[0].Count != 7 {
+		t.Fatalf("got %+v, want one profile with Count 7", merged)
+	}
+}
+
+func TestMergeProfilesSaturatesSetMode(t *testing.T) {
+	sets := [][]*cover.Profile{
+		{{FileName: "a.go", Mode: "set", Blocks: []cover.ProfileBlock{block(1, 2, 1, 1)}}},
+		{{FileName: "a.go", Mode: "set", Blocks: []cover.ProfileBlock{block(1, 2, 1, 1)}}},
+	}
+
+	merged, err := mergeProfiles(sets)
+	if err != nil {
+		t.Fatalf("mergeProfiles: %v", err)
+	}
+	if got := merged[0].Blocks[0].Count; got != 1 {
+		t.Errorf("set-mode Count = %d, want 1 (saturated)", got)
+	}
+}
+
+func TestMergeProfilesRejectsMixedModes(t *testing.T) {
+	sets := [][]*cover.Profile{
+		{{FileName: "a.go", Mode: "set", Blocks: []cover.ProfileBlock{block(1, 2, 1, 1)}}},
+		{{FileName: "a.go", Mode: "count", Blocks: []cover.ProfileBlock{block(1, 2, 1, 1)}}},
+	}
+
+	if _, err := mergeProfiles(sets); err == nil {
+		t.Fatal("mergeProfiles: want error merging set and count modes, got nil")
+	}
+}
+
+func TestMergeProfilesRejectsMismatchedBlocks(t *testing.T) {
+	sets := [][]*cover.Profile{
+		{{FileName: "a.go", Mode: "count", Blocks: []cover.ProfileBlock{block(1, 2, 1, 1)}}},
+		{{FileName: "a.go", Mode: "count", Blocks: []cover.ProfileBlock{block(3, 4, 1, 1)}}},
+	}
+
+	if _, err := mergeProfiles(sets); err == nil {
+		t.Fatal("mergeProfiles: want error when block extents don't line up across inputs, got nil")
+	}
+}
+
+func TestMergeProfilesSortsByFileName(t *testing.T) {
+	sets := [][]*cover.Profile{
+		{{FileName: "b.go", Mode: "count", Blocks: []cover.ProfileBlock{block(1, 2, 1, 1)}}},
+		{{FileName: "a.go", Mode: "count", Blocks: []cover.ProfileBlock{block(1, 2, 1, 1)}}},
+	}
+
+	merged, err := mergeProfiles(sets)
+	if err != nil {
+		t.Fatalf("mergeProfiles: %v", err)
+	}
+	if merged[0].FileName != "a.go" || merged[1].FileName != "b.go" {
+		t.Fatalf("got order %q, %q; want filename order (matching cover.ParseProfiles), regardless of which input set a file came from", merged[0].FileName, merged[1].FileName)
+	}
+}